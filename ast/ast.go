@@ -0,0 +1,121 @@
+// Package ast declares the types used to represent a parsed logical
+// expression, modeled on go/ast. A tree built from these nodes can be
+// evaluated repeatedly (see package eval) without re-parsing.
+package ast
+
+import (
+	"fmt"
+
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/token"
+)
+
+// Expr is implemented by every node that stands for a logical
+// sub-expression.
+type Expr interface {
+	// Pos returns the position of the first token belonging to the node.
+	Pos() token.Pos
+	// String renders the node back into the surface syntax.
+	String() string
+	// Equal reports whether x is structurally identical to the node,
+	// ignoring position.
+	Equal(x Expr) bool
+}
+
+// BoolLit is a literal `True` or `False`.
+type BoolLit struct {
+	ValuePos token.Pos
+	Value    bool
+}
+
+func (b *BoolLit) Pos() token.Pos { return b.ValuePos }
+
+func (b *BoolLit) String() string {
+	if b.Value {
+		return "True"
+	}
+	return "False"
+}
+
+func (b *BoolLit) Equal(x Expr) bool {
+	o, ok := x.(*BoolLit)
+	return ok && o.Value == b.Value
+}
+
+// Ident is a variable reference.
+type Ident struct {
+	NamePos token.Pos
+	Name    string
+}
+
+func (i *Ident) Pos() token.Pos { return i.NamePos }
+func (i *Ident) String() string { return i.Name }
+
+func (i *Ident) Equal(x Expr) bool {
+	o, ok := x.(*Ident)
+	return ok && o.Name == i.Name
+}
+
+// UnaryOp is a prefix operator applied to a single operand, e.g. `not x`.
+type UnaryOp struct {
+	OpPos token.Pos
+	Op    string
+	X     Expr
+}
+
+func (u *UnaryOp) Pos() token.Pos { return u.OpPos }
+
+func (u *UnaryOp) String() string {
+	return fmt.Sprintf("%s %s", u.Op, wrapOperand(u.X, precedence(u.Op), false))
+}
+
+func (u *UnaryOp) Equal(x Expr) bool {
+	o, ok := x.(*UnaryOp)
+	return ok && o.Op == u.Op && o.X.Equal(u.X)
+}
+
+// BinaryOp is an infix operator joining two operands, e.g. `x and y`.
+type BinaryOp struct {
+	X     Expr
+	OpPos token.Pos
+	Op    string
+	Y     Expr
+}
+
+func (b *BinaryOp) Pos() token.Pos { return b.X.Pos() }
+
+// String reprints the operator's operands, wrapping either of them in
+// parentheses whenever precedence (see precedence.go) would otherwise
+// let the printed text reparse into a different tree than this one -
+// e.g. an `or` nested under `and`, or an arbitrary subexpression under
+// a freshly built `not`. Both are common once package normalize starts
+// building new trees instead of just reprinting parsed ones.
+func (b *BinaryOp) String() string {
+	self := precedence(b.Op)
+	rightAssoc := b.Op == "implies"
+
+	left := wrapOperand(b.X, self, rightAssoc)
+	right := wrapOperand(b.Y, self, !rightAssoc)
+	return fmt.Sprintf("%s %s %s", left, b.Op, right)
+}
+
+func (b *BinaryOp) Equal(x Expr) bool {
+	o, ok := x.(*BinaryOp)
+	return ok && o.Op == b.Op && o.X.Equal(b.X) && o.Y.Equal(b.Y)
+}
+
+// ParenExpr is an explicitly parenthesized sub-expression. It is kept as
+// its own node (rather than discarded) so that String can reproduce the
+// original grouping.
+type ParenExpr struct {
+	Lparen token.Pos
+	X      Expr
+	Rparen token.Pos
+}
+
+func (p *ParenExpr) Pos() token.Pos { return p.Lparen }
+func (p *ParenExpr) String() string { return fmt.Sprintf("(%s)", p.X.String()) }
+
+func (p *ParenExpr) Equal(x Expr) bool {
+	o, ok := x.(*ParenExpr)
+	return ok && o.X.Equal(p.X)
+}
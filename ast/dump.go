@@ -0,0 +1,42 @@
+package ast
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Dump pretty-prints expr's tree structure, one node per line with
+// nesting shown by indentation, analogous to go/ast.Print.
+func Dump(expr Expr) string {
+	var b strings.Builder
+	dump(&b, expr, 0)
+	return b.String()
+}
+
+func dump(b *strings.Builder, expr Expr, depth int) {
+	indent := strings.Repeat("  ", depth)
+
+	switch e := expr.(type) {
+	case *BoolLit:
+		fmt.Fprintf(b, "%sBoolLit %v\n", indent, e.Value)
+
+	case *Ident:
+		fmt.Fprintf(b, "%sIdent %s\n", indent, e.Name)
+
+	case *UnaryOp:
+		fmt.Fprintf(b, "%sUnaryOp %s\n", indent, e.Op)
+		dump(b, e.X, depth+1)
+
+	case *BinaryOp:
+		fmt.Fprintf(b, "%sBinaryOp %s\n", indent, e.Op)
+		dump(b, e.X, depth+1)
+		dump(b, e.Y, depth+1)
+
+	case *ParenExpr:
+		fmt.Fprintf(b, "%sParenExpr\n", indent)
+		dump(b, e.X, depth+1)
+
+	default:
+		fmt.Fprintf(b, "%s%T\n", indent, expr)
+	}
+}
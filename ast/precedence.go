@@ -0,0 +1,50 @@
+package ast
+
+// precedenceOf mirrors the parser's level order (package parser,
+// loosest to tightest: iff, implies, or, xor, and, nand/nor, not) so
+// that BinaryOp.String and UnaryOp.String know when an operand needs
+// parentheses to reparse into the same tree.
+var precedenceOf = map[string]int{
+	"iff":     1,
+	"implies": 2,
+	"or":      3,
+	"xor":     4,
+	"and":     5,
+	"nand":    6,
+	"nor":     6,
+	"not":     7,
+}
+
+// atomPrecedence is higher than every operator's, since an Ident,
+// BoolLit, or ParenExpr never needs extra grouping to stand as an
+// operand.
+const atomPrecedence = 8
+
+func precedence(op string) int { return precedenceOf[op] }
+
+// exprPrecedence reports the binding precedence of expr's outermost
+// operator, or atomPrecedence if expr is already atomic (including an
+// explicit ParenExpr, which is parenthesized regardless).
+func exprPrecedence(expr Expr) int {
+	switch e := expr.(type) {
+	case *BinaryOp:
+		return precedence(e.Op)
+	case *UnaryOp:
+		return precedence(e.Op)
+	default:
+		return atomPrecedence
+	}
+}
+
+// wrapOperand renders child as it should appear as an operand of a
+// parent operator whose own precedence is selfPrec, parenthesizing it
+// when its precedence is looser than selfPrec, or tied with selfPrec on
+// the side where that tie would otherwise associate the wrong way
+// (tiesNeedParens).
+func wrapOperand(child Expr, selfPrec int, tiesNeedParens bool) string {
+	p := exprPrecedence(child)
+	if p < selfPrec || (p == selfPrec && tiesNeedParens) {
+		return "(" + child.String() + ")"
+	}
+	return child.String()
+}
@@ -0,0 +1,31 @@
+package ast
+
+import "sort"
+
+// FreeVars returns the distinct variable names referenced by expr, in
+// sorted order.
+func FreeVars(expr Expr) []string {
+	seen := make(map[string]bool)
+	collectVars(expr, seen)
+
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func collectVars(expr Expr, seen map[string]bool) {
+	switch e := expr.(type) {
+	case *Ident:
+		seen[e.Name] = true
+	case *UnaryOp:
+		collectVars(e.X, seen)
+	case *BinaryOp:
+		collectVars(e.X, seen)
+		collectVars(e.Y, seen)
+	case *ParenExpr:
+		collectVars(e.X, seen)
+	}
+}
@@ -0,0 +1,78 @@
+// Package eval evaluates an ast.Expr against a variable assignment.
+// Because the AST is separate from parsing, the same tree can be
+// evaluated many times with different vars.
+package eval
+
+import (
+	"fmt"
+
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/ast"
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/perr"
+)
+
+// Eval evaluates expr using vars as the truth assignment for its free
+// variables. It returns an error if expr references a variable not
+// present in vars.
+func Eval(expr ast.Expr, vars map[string]bool) (bool, error) {
+	switch e := expr.(type) {
+	case *ast.BoolLit:
+		return e.Value, nil
+
+	case *ast.Ident:
+		value, exists := vars[e.Name]
+		if !exists {
+			return false, &perr.Error{
+				Pos:  e.NamePos,
+				Code: perr.ErrUnknownIdent,
+				Msg:  fmt.Sprintf("unknown variable %q", e.Name),
+			}
+		}
+		return value, nil
+
+	case *ast.ParenExpr:
+		return Eval(e.X, vars)
+
+	case *ast.UnaryOp:
+		x, err := Eval(e.X, vars)
+		if err != nil {
+			return false, err
+		}
+		switch e.Op {
+		case "not":
+			return !x, nil
+		default:
+			return false, fmt.Errorf("eval: unknown unary operator %q", e.Op)
+		}
+
+	case *ast.BinaryOp:
+		x, err := Eval(e.X, vars)
+		if err != nil {
+			return false, err
+		}
+		y, err := Eval(e.Y, vars)
+		if err != nil {
+			return false, err
+		}
+		switch e.Op {
+		case "and":
+			return x && y, nil
+		case "or":
+			return x || y, nil
+		case "xor":
+			return (x && !y) || (!x && y), nil
+		case "implies":
+			return !x || y, nil
+		case "iff":
+			return x == y, nil
+		case "nand":
+			return !(x && y), nil
+		case "nor":
+			return !(x || y), nil
+		default:
+			return false, fmt.Errorf("eval: unknown binary operator %q", e.Op)
+		}
+
+	default:
+		return false, fmt.Errorf("eval: unhandled expression type %T", expr)
+	}
+}
@@ -0,0 +1,178 @@
+// Package lexer turns source text into a stream of tokens for the
+// parser, modeled on go/scanner. Every token carries the position of
+// its first byte, and failures are reported as a *perr.Error pinpointing
+// the offending byte.
+package lexer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/perr"
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/token"
+)
+
+var operators = map[string]bool{
+	"and": true, "or": true, "not": true, "xor": true,
+	"implies": true, "iff": true, "nand": true, "nor": true,
+}
+
+var booleans = map[string]bool{
+	"True": true, "False": true,
+}
+
+var keywords = map[string]bool{
+	"and":     true,
+	"or":      true,
+	"not":     true,
+	"xor":     true,
+	"implies": true,
+	"iff":     true,
+	"nand":    true,
+	"nor":     true,
+	"true":    true,
+	"false":   true,
+}
+
+// IsValidVarName reports whether name can be used as a variable
+// identifier: lowercase letters only, and not one of the reserved
+// keywords.
+func IsValidVarName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, char := range name {
+		if char < 'a' || char > 'z' {
+			return false
+		}
+	}
+	return !keywords[name]
+}
+
+// isLetter reports whether ch can appear in a word token. Words are
+// scanned case-insensitively so that the capitalized `True`/`False`
+// literals reach wordType alongside lowercase keywords and identifiers;
+// wordType (via IsValidVarName) still rejects anything uppercase that
+// isn't exactly one of those two literals.
+func isLetter(ch byte) bool {
+	return (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z')
+}
+
+func wordType(word string) token.Type {
+	if operators[word] {
+		return token.OPERATOR
+	}
+	if booleans[word] {
+		return token.BOOL
+	}
+	if IsValidVarName(word) {
+		return token.IDENT
+	}
+	return token.EOF
+}
+
+// lexer tracks the byte offset, line, and column of the next rune to be
+// consumed from src.
+type lexer struct {
+	filename string
+	src      string
+	offset   int
+	line     int
+	column   int
+}
+
+func (l *lexer) pos() token.Position {
+	return token.Position{Filename: l.filename, Offset: l.offset, Line: l.line, Column: l.column}
+}
+
+func (l *lexer) advance() {
+	l.offset++
+	l.column++
+}
+
+// Lex tokenizes expression, returning a *perr.Error if it contains a
+// character or word that cannot be classified.
+func Lex(expression string) ([]token.Token, error) {
+	return LexFile("", expression)
+}
+
+// LexFile is Lex with an explicit filename, which every token's position
+// (and any error's position) then carries.
+func LexFile(filename, expression string) ([]token.Token, error) {
+	l := &lexer{filename: filename, src: expression, line: 1, column: 1}
+
+	var tokens []token.Token
+	n := len(l.src)
+
+	for l.offset < n {
+		ch := l.src[l.offset]
+
+		if ch == ' ' {
+			l.advance()
+			continue
+		}
+
+		if ch == '\n' {
+			l.offset++
+			l.line++
+			l.column = 1
+			continue
+		}
+
+		if ch == '(' {
+			tokens = append(tokens, token.Token{Type: token.LPAREN, Value: "(", Pos: l.pos()})
+			l.advance()
+			continue
+		}
+
+		if ch == ')' {
+			tokens = append(tokens, token.Token{Type: token.RPAREN, Value: ")", Pos: l.pos()})
+			l.advance()
+			continue
+		}
+
+		if ch == '<' && strings.HasPrefix(l.src[l.offset:], "<->") {
+			start := l.pos()
+			l.advance()
+			l.advance()
+			l.advance()
+			tokens = append(tokens, token.Token{Type: token.OPERATOR, Value: "iff", Pos: start})
+			continue
+		}
+
+		if ch == '-' && strings.HasPrefix(l.src[l.offset:], "->") {
+			start := l.pos()
+			l.advance()
+			l.advance()
+			tokens = append(tokens, token.Token{Type: token.OPERATOR, Value: "implies", Pos: start})
+			continue
+		}
+
+		if isLetter(ch) {
+			start := l.pos()
+			startOffset := l.offset
+			for l.offset < n && isLetter(l.src[l.offset]) {
+				l.advance()
+			}
+			word := l.src[startOffset:l.offset]
+			tokenType := wordType(word)
+			if tokenType == token.EOF {
+				return nil, &perr.Error{
+					Pos:  start,
+					Code: perr.ErrUnexpectedToken,
+					Msg:  fmt.Sprintf("invalid word %q", word),
+				}
+			}
+
+			tokens = append(tokens, token.Token{Type: tokenType, Value: word, Pos: start})
+			continue
+		}
+
+		return nil, &perr.Error{
+			Pos:  l.pos(),
+			Code: perr.ErrUnexpectedChar,
+			Msg:  fmt.Sprintf("unexpected character %q", ch),
+		}
+	}
+	return tokens, nil
+}
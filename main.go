@@ -0,0 +1,199 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/ast"
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/eval"
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/normalize"
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/parser"
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/perr"
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/repl"
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/token"
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/truthtable"
+)
+
+var (
+	truthtableFlag = flag.Bool("truthtable", false, "print the truth table of the expression instead of evaluating it")
+	checkFlag      = flag.String("check", "", "instead of evaluating, report yes/no for one of: tautology, contradiction, satisfiable")
+	formatFlag     = flag.String("format", "text", "truth table output format: text, csv, or markdown")
+	normalizeFlag  = flag.String("normalize", "", "print the expression rewritten to one of: nnf, cnf, dnf, simplify")
+	replFlag       = flag.Bool("repl", false, "run an interactive REPL instead of the one-shot batch mode")
+)
+
+func main() {
+	flag.Parse()
+
+	if *replFlag || (isTerminal(os.Stdin) && !batchFlagsSet()) {
+		repl.New(os.Stdout).Run(os.Stdin)
+		return
+	}
+
+	runBatch()
+}
+
+// batchFlagsSet reports whether the user explicitly passed one of the
+// one-shot batch flags, which should take precedence over defaulting to
+// the REPL just because stdin happens to be a terminal.
+func batchFlagsSet() bool {
+	set := false
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "truthtable", "check", "normalize":
+			set = true
+		}
+	})
+	return set
+}
+
+// isTerminal reports whether f is attached to an interactive terminal
+// rather than a pipe or redirected file.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// runBatch is the original one-shot mode: read variable declarations
+// followed by a single expression from stdin, then evaluate (or
+// truth-table/check/normalize) it once and exit.
+func runBatch() {
+	scanner := bufio.NewScanner(os.Stdin)
+	variables := make(map[string]bool)
+	var expression string
+	var errs perr.ErrorList
+	lineNo := 0
+
+	for scanner.Scan() {
+		lineNo++
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+
+		if parser.IsDeclaration(trimmed) {
+			pos := token.Position{Filename: "<stdin>", Line: lineNo, Column: 1}
+			name, value, err := parser.ParseDeclaration(trimmed, pos)
+			if err != nil {
+				errs.Add(pos, err.(*perr.Error).Code, err.(*perr.Error).Msg)
+				continue
+			}
+			variables[name] = value
+		} else {
+			expression = trimmed
+			break
+		}
+	}
+
+	if len(errs) > 0 {
+		for _, e := range errs {
+			fmt.Println(e.Error())
+		}
+		fmt.Println("[error]")
+		return
+	}
+
+	if expression == "" {
+		fmt.Println("[error]")
+		return
+	}
+
+	expr, err := parser.Parse(expression)
+	if err != nil {
+		fmt.Println(err)
+		fmt.Println("[error]")
+		return
+	}
+
+	if *normalizeFlag != "" {
+		runNormalize(expr, *normalizeFlag)
+		return
+	}
+
+	if *checkFlag != "" {
+		runCheck(expr, *checkFlag)
+		return
+	}
+
+	if *truthtableFlag {
+		runTruthTable(expr, *formatFlag)
+		return
+	}
+
+	result, err := eval.Eval(expr, variables)
+	if err != nil {
+		fmt.Println(err)
+		fmt.Println("[error]")
+		return
+	}
+
+	if result {
+		fmt.Println("True")
+	} else {
+		fmt.Println("False")
+	}
+}
+
+// runCheck answers one of the -check queries for expr.
+func runCheck(expr ast.Expr, check string) {
+	table := truthtable.Build(expr)
+
+	var answer bool
+	switch check {
+	case "tautology":
+		answer = table.IsTautology()
+	case "contradiction":
+		answer = table.IsContradiction()
+	case "satisfiable":
+		answer = table.IsSatisfiable()
+	default:
+		fmt.Printf("[error] unknown -check value %q, want tautology, contradiction, or satisfiable\n", check)
+		return
+	}
+
+	if answer {
+		fmt.Println("True")
+	} else {
+		fmt.Println("False")
+	}
+}
+
+// runNormalize prints expr rewritten according to mode.
+func runNormalize(expr ast.Expr, mode string) {
+	var out ast.Expr
+	switch mode {
+	case "nnf":
+		out = normalize.ToNNF(expr)
+	case "cnf":
+		out = normalize.ToCNF(expr)
+	case "dnf":
+		out = normalize.ToDNF(expr)
+	case "simplify":
+		out = normalize.Simplify(expr)
+	default:
+		fmt.Printf("[error] unknown -normalize value %q, want nnf, cnf, dnf, or simplify\n", mode)
+		return
+	}
+	fmt.Println(out.String())
+}
+
+// runTruthTable prints expr's truth table in the given format.
+func runTruthTable(expr ast.Expr, format string) {
+	table := truthtable.Build(expr)
+
+	switch format {
+	case "csv":
+		fmt.Print(table.CSV())
+	case "markdown":
+		fmt.Print(table.Markdown())
+	default:
+		fmt.Print(table.Text())
+	}
+}
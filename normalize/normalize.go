@@ -0,0 +1,138 @@
+// Package normalize rewrites an ast.Expr into negation normal form
+// (NNF), conjunctive normal form (CNF), or disjunctive normal form
+// (DNF), and provides a constant-folding Simplify pass. Variable
+// identity is preserved throughout via ast.Ident.Name, so a normalized
+// expression can still be evaluated with the original vars map.
+package normalize
+
+import "github.com/EvKrpv/Go-Logical_Expression_Parser/ast"
+
+// ToNNF rewrites expr into negation normal form: `implies`, `iff`,
+// `xor`, `nand`, and `nor` are expanded into `and`/`or`/`not`, and every
+// `not` is pushed down to a literal via De Morgan's laws, canceling
+// double negations along the way.
+func ToNNF(expr ast.Expr) ast.Expr {
+	return nnf(eliminate(expr), false)
+}
+
+// ToCNF rewrites expr into conjunctive normal form: an `and` of clauses,
+// each of which is an `or` of literals.
+func ToCNF(expr ast.Expr) ast.Expr {
+	return Simplify(distribute("or", "and", ToNNF(expr)))
+}
+
+// ToDNF rewrites expr into disjunctive normal form: an `or` of terms,
+// each of which is an `and` of literals.
+func ToDNF(expr ast.Expr) ast.Expr {
+	return Simplify(distribute("and", "or", ToNNF(expr)))
+}
+
+// eliminate rewrites every `implies`, `iff`, `xor`, `nand`, and `nor`
+// node into an equivalent using only `and`, `or`, and `not`.
+func eliminate(expr ast.Expr) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.BoolLit:
+		return &ast.BoolLit{Value: e.Value}
+
+	case *ast.Ident:
+		return &ast.Ident{Name: e.Name}
+
+	case *ast.ParenExpr:
+		return eliminate(e.X)
+
+	case *ast.UnaryOp:
+		return not(eliminate(e.X))
+
+	case *ast.BinaryOp:
+		x, y := eliminate(e.X), eliminate(e.Y)
+		switch e.Op {
+		case "and", "or":
+			return &ast.BinaryOp{X: x, Op: e.Op, Y: y}
+		case "xor":
+			return or(and(x, not(y)), and(not(x), y))
+		case "implies":
+			return or(not(x), y)
+		case "iff":
+			return or(and(x, y), and(not(x), not(y)))
+		case "nand":
+			return not(and(x, y))
+		case "nor":
+			return not(or(x, y))
+		}
+	}
+	return expr
+}
+
+// nnf pushes negation through an already-eliminated (and/or/not/literal
+// only) expression. neg reports whether the node is under an odd number
+// of enclosing `not`s.
+func nnf(expr ast.Expr, neg bool) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.BoolLit:
+		return &ast.BoolLit{Value: e.Value != neg}
+
+	case *ast.Ident:
+		if neg {
+			return not(&ast.Ident{Name: e.Name})
+		}
+		return &ast.Ident{Name: e.Name}
+
+	case *ast.UnaryOp: // always "not" after elimination
+		return nnf(e.X, !neg)
+
+	case *ast.BinaryOp: // always "and"/"or" after elimination
+		op := e.Op
+		if neg {
+			op = dual(op)
+		}
+		return &ast.BinaryOp{X: nnf(e.X, neg), Op: op, Y: nnf(e.Y, neg)}
+
+	case *ast.ParenExpr:
+		return nnf(e.X, neg)
+	}
+	return expr
+}
+
+func dual(op string) string {
+	if op == "and" {
+		return "or"
+	}
+	return "and"
+}
+
+// distribute applies the distributive law of over across into,
+// recursively, turning e.g. `a or (b and c)` into
+// `(a or b) and (a or c)` when over, into = "or", "and".
+func distribute(over, into string, expr ast.Expr) ast.Expr {
+	b, ok := expr.(*ast.BinaryOp)
+	if !ok {
+		return expr
+	}
+
+	x := distribute(over, into, b.X)
+	y := distribute(over, into, b.Y)
+
+	if b.Op != over {
+		return &ast.BinaryOp{X: x, Op: b.Op, Y: y}
+	}
+
+	if bx, ok := x.(*ast.BinaryOp); ok && bx.Op == into {
+		return &ast.BinaryOp{
+			X:  distribute(over, into, &ast.BinaryOp{X: bx.X, Op: over, Y: y}),
+			Op: into,
+			Y:  distribute(over, into, &ast.BinaryOp{X: bx.Y, Op: over, Y: y}),
+		}
+	}
+	if by, ok := y.(*ast.BinaryOp); ok && by.Op == into {
+		return &ast.BinaryOp{
+			X:  distribute(over, into, &ast.BinaryOp{X: x, Op: over, Y: by.X}),
+			Op: into,
+			Y:  distribute(over, into, &ast.BinaryOp{X: x, Op: over, Y: by.Y}),
+		}
+	}
+	return &ast.BinaryOp{X: x, Op: over, Y: y}
+}
+
+func not(x ast.Expr) ast.Expr    { return &ast.UnaryOp{Op: "not", X: x} }
+func and(x, y ast.Expr) ast.Expr { return &ast.BinaryOp{X: x, Op: "and", Y: y} }
+func or(x, y ast.Expr) ast.Expr  { return &ast.BinaryOp{X: x, Op: "or", Y: y} }
@@ -0,0 +1,101 @@
+package normalize_test
+
+import (
+	"testing"
+
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/ast"
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/eval"
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/normalize"
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/parser"
+)
+
+func mustParse(t *testing.T, src string) ast.Expr {
+	t.Helper()
+	expr, err := parser.Parse(src)
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", src, err)
+	}
+	return expr
+}
+
+// mustEval evaluates expr under assignment, failing the test on error
+// rather than returning it, since every variable here is always bound.
+func mustEval(t *testing.T, expr ast.Expr, assignment map[string]bool) bool {
+	t.Helper()
+	value, err := eval.Eval(expr, assignment)
+	if err != nil {
+		t.Fatalf("Eval: %v", err)
+	}
+	return value
+}
+
+// checkEquivalent brute-force-compares want against got over every
+// assignment of vars, so a rewrite pass that silently changes meaning
+// (rather than just shape) is caught regardless of which assignment
+// happens to expose it. got is round-tripped through String and
+// parser.Parse first, the same path a user of -normalize depends on, so
+// a rewrite whose tree is correct but whose printed form reparses into
+// something else (missing parentheses around a looser-binding operand)
+// is caught too.
+func checkEquivalent(t *testing.T, name string, want, got ast.Expr, vars []string) {
+	t.Helper()
+
+	printed := got.String()
+	reparsed, err := parser.Parse(printed)
+	if err != nil {
+		t.Fatalf("%s: Parse(%q): %v", name, printed, err)
+	}
+
+	n := len(vars)
+	for mask := 0; mask < (1 << n); mask++ {
+		assignment := make(map[string]bool, n)
+		for i, v := range vars {
+			assignment[v] = mask&(1<<i) != 0
+		}
+		wantValue := mustEval(t, want, assignment)
+		gotValue := mustEval(t, reparsed, assignment)
+		if wantValue != gotValue {
+			t.Errorf("%s: assignment %v: original = %v, reparsed %q = %v",
+				name, assignment, wantValue, printed, gotValue)
+		}
+	}
+}
+
+// TestNormalizeSemanticEquivalence checks that ToNNF, ToCNF, ToDNF, and
+// Simplify never change an expression's meaning, only its shape, by
+// comparing every rewrite against eval.Eval on the original over every
+// assignment of its free variables.
+func TestNormalizeSemanticEquivalence(t *testing.T) {
+	exprs := []string{
+		"a and b",
+		"a or b",
+		"not a",
+		"a xor b",
+		"a implies b",
+		"a iff b",
+		"a nand b",
+		"a nor b",
+		"(a and b) or (not a and c)",
+		"a implies (b implies c)",
+		"(a xor b) iff (c nand d)",
+		"not (a and not b) or (c nor d)",
+		"a and True",
+		"a or False",
+		"a and not a",
+		"a or not a",
+		"(a iff b) and (b iff c) and (a iff c)",
+	}
+
+	for _, src := range exprs {
+		src := src
+		t.Run(src, func(t *testing.T) {
+			expr := mustParse(t, src)
+			vars := ast.FreeVars(expr)
+
+			checkEquivalent(t, "NNF", expr, normalize.ToNNF(expr), vars)
+			checkEquivalent(t, "CNF", expr, normalize.ToCNF(expr), vars)
+			checkEquivalent(t, "DNF", expr, normalize.ToDNF(expr), vars)
+			checkEquivalent(t, "Simplify", expr, normalize.Simplify(expr), vars)
+		})
+	}
+}
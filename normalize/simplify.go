@@ -0,0 +1,162 @@
+package normalize
+
+import "github.com/EvKrpv/Go-Logical_Expression_Parser/ast"
+
+// Simplify folds constants (`x and True` -> `x`, `x or False` -> `x`,
+// `x and not x` -> `False`, etc.), cancels double negation, and
+// deduplicates a binary operator's two operands when they are
+// structurally identical (`x and x` -> `x`).
+func Simplify(expr ast.Expr) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.BoolLit:
+		return &ast.BoolLit{Value: e.Value}
+
+	case *ast.Ident:
+		return &ast.Ident{Name: e.Name}
+
+	case *ast.ParenExpr:
+		return Simplify(e.X)
+
+	case *ast.UnaryOp:
+		x := Simplify(e.X)
+		if b, ok := x.(*ast.BoolLit); ok {
+			return &ast.BoolLit{Value: !b.Value}
+		}
+		if u, ok := x.(*ast.UnaryOp); ok && u.Op == "not" {
+			return u.X
+		}
+		return &ast.UnaryOp{Op: e.Op, X: x}
+
+	case *ast.BinaryOp:
+		return simplifyBinary(e.Op, Simplify(e.X), Simplify(e.Y))
+	}
+	return expr
+}
+
+func simplifyBinary(op string, x, y ast.Expr) ast.Expr {
+	bx, xConst := x.(*ast.BoolLit)
+	by, yConst := y.(*ast.BoolLit)
+
+	switch op {
+	case "and":
+		if xConst {
+			if !bx.Value {
+				return &ast.BoolLit{Value: false}
+			}
+			return y
+		}
+		if yConst {
+			if !by.Value {
+				return &ast.BoolLit{Value: false}
+			}
+			return x
+		}
+		if x.Equal(y) {
+			return x
+		}
+		if isNegationOf(x, y) {
+			return &ast.BoolLit{Value: false}
+		}
+
+	case "or":
+		if xConst {
+			if bx.Value {
+				return &ast.BoolLit{Value: true}
+			}
+			return y
+		}
+		if yConst {
+			if by.Value {
+				return &ast.BoolLit{Value: true}
+			}
+			return x
+		}
+		if x.Equal(y) {
+			return x
+		}
+		if isNegationOf(x, y) {
+			return &ast.BoolLit{Value: true}
+		}
+
+	case "xor":
+		if xConst && yConst {
+			return &ast.BoolLit{Value: bx.Value != by.Value}
+		}
+		if xConst {
+			if bx.Value {
+				return &ast.UnaryOp{Op: "not", X: y}
+			}
+			return y
+		}
+		if yConst {
+			if by.Value {
+				return &ast.UnaryOp{Op: "not", X: x}
+			}
+			return x
+		}
+		if x.Equal(y) {
+			return &ast.BoolLit{Value: false}
+		}
+
+	case "implies":
+		if xConst {
+			if !bx.Value {
+				return &ast.BoolLit{Value: true}
+			}
+			return y
+		}
+		if yConst {
+			if by.Value {
+				return &ast.BoolLit{Value: true}
+			}
+			return &ast.UnaryOp{Op: "not", X: x}
+		}
+		if x.Equal(y) {
+			return &ast.BoolLit{Value: true}
+		}
+
+	case "iff":
+		if xConst && yConst {
+			return &ast.BoolLit{Value: bx.Value == by.Value}
+		}
+		if xConst {
+			if bx.Value {
+				return y
+			}
+			return &ast.UnaryOp{Op: "not", X: y}
+		}
+		if yConst {
+			if by.Value {
+				return x
+			}
+			return &ast.UnaryOp{Op: "not", X: x}
+		}
+		if x.Equal(y) {
+			return &ast.BoolLit{Value: true}
+		}
+
+	case "nand":
+		if xConst && yConst {
+			return &ast.BoolLit{Value: !(bx.Value && by.Value)}
+		}
+
+	case "nor":
+		if xConst && yConst {
+			return &ast.BoolLit{Value: !(bx.Value || by.Value)}
+		}
+	}
+
+	return &ast.BinaryOp{X: x, Op: op, Y: y}
+}
+
+// isNegationOf reports whether one of x, y is syntactically `not` the
+// other.
+func isNegationOf(x, y ast.Expr) bool {
+	if u, ok := x.(*ast.UnaryOp); ok && u.Op == "not" && u.X.Equal(y) {
+		return true
+	}
+	if u, ok := y.(*ast.UnaryOp); ok && u.Op == "not" && u.X.Equal(x) {
+		return true
+	}
+	return false
+}
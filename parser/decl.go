@@ -0,0 +1,50 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/lexer"
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/perr"
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/token"
+)
+
+// ParseDeclaration parses a single `name = True;` / `name = False;` line
+// into a variable binding. pos anchors any reported error.
+func ParseDeclaration(line string, pos token.Position) (string, bool, error) {
+	line = strings.ReplaceAll(line, " ", "")
+
+	if !strings.Contains(line, "=") || !strings.HasSuffix(line, ";") {
+		return "", false, &perr.Error{Pos: pos, Code: perr.ErrInvalidDeclaration, Msg: "expected \"name = True;\" or \"name = False;\""}
+	}
+
+	parts := strings.Split(line, "=")
+	if len(parts) != 2 {
+		return "", false, &perr.Error{Pos: pos, Code: perr.ErrInvalidDeclaration, Msg: "expected exactly one \"=\""}
+	}
+
+	varName := parts[0]
+	valueStr := strings.TrimSuffix(parts[1], ";")
+
+	if !lexer.IsValidVarName(varName) {
+		return "", false, &perr.Error{Pos: pos, Code: perr.ErrInvalidDeclaration, Msg: fmt.Sprintf("invalid variable name %q", varName)}
+	}
+
+	var value bool
+	switch valueStr {
+	case "True":
+		value = true
+	case "False":
+		value = false
+	default:
+		return "", false, &perr.Error{Pos: pos, Code: perr.ErrInvalidDeclaration, Msg: fmt.Sprintf("invalid value %q, want True or False", valueStr)}
+	}
+
+	return varName, value, nil
+}
+
+// IsDeclaration reports whether line has the shape of a declaration
+// (`name = True;`) rather than an expression.
+func IsDeclaration(line string) bool {
+	return strings.Contains(line, "=") && strings.HasSuffix(line, ";")
+}
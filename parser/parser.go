@@ -0,0 +1,232 @@
+// Package parser builds an ast.Expr from source text, modeled on
+// go/parser. Unlike the original implementation, parsing is entirely
+// separate from evaluation: the resulting tree can be evaluated
+// repeatedly against different variable assignments via package eval,
+// pretty-printed, or otherwise transformed.
+package parser
+
+import (
+	"fmt"
+
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/ast"
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/lexer"
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/perr"
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/token"
+)
+
+type parser struct {
+	tokens []token.Token
+	pos    int
+}
+
+// Parse lexes and parses src, returning the resulting expression tree.
+func Parse(src string) (ast.Expr, error) {
+	tokens, err := lexer.Lex(src)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	expr, err := p.parseIff()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.pos != len(p.tokens) {
+		return nil, &perr.Error{
+			Pos:  p.tokens[p.pos].Pos,
+			Code: perr.ErrUnexpectedToken,
+			Msg:  fmt.Sprintf("unexpected trailing token %q", p.tokens[p.pos].Value),
+		}
+	}
+	return expr, nil
+}
+
+func (p *parser) peek() (token.Token, bool) {
+	if p.pos >= len(p.tokens) {
+		return token.Token{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+// parseIff is the loosest-binding level: `iff` (<->), left-associative.
+func (p *parser) parseIff() (ast.Expr, error) {
+	left, err := p.parseImplies()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		tok, ok := p.peek()
+		if !ok || tok.Value != "iff" {
+			break
+		}
+		p.pos++
+		right, err := p.parseImplies()
+		if err != nil {
+			return nil, err
+		}
+		left = &ast.BinaryOp{X: left, OpPos: tok.Pos, Op: "iff", Y: right}
+	}
+	return left, nil
+}
+
+// parseImplies handles `implies` (->), which is right-associative: `a
+// implies b implies c` parses as `a implies (b implies c)`.
+func (p *parser) parseImplies() (ast.Expr, error) {
+	left, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	tok, ok := p.peek()
+	if !ok || tok.Value != "implies" {
+		return left, nil
+	}
+	p.pos++
+
+	right, err := p.parseImplies()
+	if err != nil {
+		return nil, err
+	}
+	return &ast.BinaryOp{X: left, OpPos: tok.Pos, Op: "implies", Y: right}, nil
+}
+
+func (p *parser) parseOr() (ast.Expr, error) {
+	left, err := p.parseXor()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		tok, ok := p.peek()
+		if !ok || tok.Value != "or" {
+			break
+		}
+		p.pos++
+		right, err := p.parseXor()
+		if err != nil {
+			return nil, err
+		}
+		left = &ast.BinaryOp{X: left, OpPos: tok.Pos, Op: "or", Y: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseXor() (ast.Expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		tok, ok := p.peek()
+		if !ok || tok.Value != "xor" {
+			break
+		}
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &ast.BinaryOp{X: left, OpPos: tok.Pos, Op: "xor", Y: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (ast.Expr, error) {
+	left, err := p.parseNandNor()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		tok, ok := p.peek()
+		if !ok || tok.Value != "and" {
+			break
+		}
+		p.pos++
+		right, err := p.parseNandNor()
+		if err != nil {
+			return nil, err
+		}
+		left = &ast.BinaryOp{X: left, OpPos: tok.Pos, Op: "and", Y: right}
+	}
+	return left, nil
+}
+
+// parseNandNor is the tightest-binding binary level: `nand` and `nor`.
+func (p *parser) parseNandNor() (ast.Expr, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		tok, ok := p.peek()
+		if !ok || (tok.Value != "nand" && tok.Value != "nor") {
+			break
+		}
+		p.pos++
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &ast.BinaryOp{X: left, OpPos: tok.Pos, Op: tok.Value, Y: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseNot() (ast.Expr, error) {
+	if tok, ok := p.peek(); ok && tok.Value == "not" {
+		p.pos++
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &ast.UnaryOp{OpPos: tok.Pos, Op: "not", X: operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (ast.Expr, error) {
+	tok, ok := p.peek()
+	if !ok {
+		return nil, &perr.Error{Code: perr.ErrUnexpectedEOF, Msg: "unexpected end of input"}
+	}
+
+	switch tok.Type {
+	case token.IDENT:
+		p.pos++
+		return &ast.Ident{NamePos: tok.Pos, Name: tok.Value}, nil
+
+	case token.BOOL:
+		p.pos++
+		return &ast.BoolLit{ValuePos: tok.Pos, Value: tok.Value == "True"}, nil
+
+	case token.LPAREN:
+		p.pos++
+		inner, err := p.parseIff()
+		if err != nil {
+			return nil, err
+		}
+
+		rparen, ok := p.peek()
+		if !ok || rparen.Type != token.RPAREN {
+			pos := tok.Pos
+			if ok {
+				pos = rparen.Pos
+			}
+			return nil, &perr.Error{Pos: pos, Code: perr.ErrMissingRParen, Msg: "missing closing parenthesis"}
+		}
+		p.pos++
+		return &ast.ParenExpr{Lparen: tok.Pos, X: inner, Rparen: rparen.Pos}, nil
+
+	default:
+		return nil, &perr.Error{
+			Pos:  tok.Pos,
+			Code: perr.ErrUnexpectedToken,
+			Msg:  fmt.Sprintf("unexpected token %q", tok.Value),
+		}
+	}
+}
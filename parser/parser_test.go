@@ -0,0 +1,88 @@
+package parser_test
+
+import (
+	"testing"
+
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/eval"
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/parser"
+)
+
+func mustEval(t *testing.T, src string, vars map[string]bool) bool {
+	t.Helper()
+	expr, err := parser.Parse(src)
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", src, err)
+	}
+	result, err := eval.Eval(expr, vars)
+	if err != nil {
+		t.Fatalf("Eval(%q): %v", src, err)
+	}
+	return result
+}
+
+func TestPrecedenceAndAssociativity(t *testing.T) {
+	tests := []struct {
+		src  string
+		vars map[string]bool
+		want bool
+	}{
+		// iff is loosest: `a or b iff c` parses as `(a or b) iff c`.
+		{"a or b iff c", map[string]bool{"a": true, "b": false, "c": true}, true},
+		{"a or b iff c", map[string]bool{"a": false, "b": false, "c": true}, false},
+
+		// implies binds tighter than iff but looser than or.
+		{"a iff b implies c", map[string]bool{"a": true, "b": true, "c": false}, false},
+		{"a iff b implies c", map[string]bool{"a": false, "b": true, "c": false}, true},
+
+		// implies is right-associative: `a implies b implies c` is
+		// `a implies (b implies c)`, which is only false when a and b
+		// are true and c is false.
+		{"a implies b implies c", map[string]bool{"a": true, "b": true, "c": false}, false},
+		{"a implies b implies c", map[string]bool{"a": true, "b": false, "c": false}, true},
+		{"a implies b implies c", map[string]bool{"a": false, "b": true, "c": false}, true},
+
+		// or binds tighter than implies: `a implies b or c` is
+		// `a implies (b or c)`.
+		{"a implies b or c", map[string]bool{"a": true, "b": false, "c": false}, false},
+		{"a implies b or c", map[string]bool{"a": true, "b": true, "c": false}, true},
+
+		// xor binds tighter than or.
+		{"a or b xor c", map[string]bool{"a": false, "b": true, "c": true}, false},
+		{"a or b xor c", map[string]bool{"a": true, "b": true, "c": true}, true},
+
+		// and binds tighter than xor.
+		{"a xor b and c", map[string]bool{"a": true, "b": true, "c": false}, true},
+		{"a xor b and c", map[string]bool{"a": false, "b": true, "c": true}, true},
+
+		// nand/nor bind tighter than and.
+		{"a and b nand c", map[string]bool{"a": true, "b": true, "c": true}, false},
+		{"a and b nand c", map[string]bool{"a": true, "b": false, "c": true}, true},
+		{"a and b nor c", map[string]bool{"a": true, "b": false, "c": false}, true},
+		{"a and b nor c", map[string]bool{"a": true, "b": true, "c": false}, false},
+
+		// symbolic aliases parse the same as the word forms.
+		{"a -> b", map[string]bool{"a": true, "b": false}, false},
+		{"a <-> b", map[string]bool{"a": true, "b": true}, true},
+
+		// not binds tighter than any binary operator.
+		{"not a and b", map[string]bool{"a": false, "b": true}, true},
+		{"not a nand b", map[string]bool{"a": false, "b": false}, true},
+	}
+
+	for _, tt := range tests {
+		got := mustEval(t, tt.src, tt.vars)
+		if got != tt.want {
+			t.Errorf("eval(%q, %v) = %v, want %v", tt.src, tt.vars, got, tt.want)
+		}
+	}
+}
+
+func TestImpliesRightAssociativeShape(t *testing.T) {
+	expr, err := parser.Parse("a implies b implies c")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if got, want := expr.String(), "a implies b implies c"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
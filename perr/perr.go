@@ -0,0 +1,63 @@
+// Package perr provides the structured, positioned error type shared by
+// the lexer and parser packages, modeled on go/scanner.Error and
+// go/scanner.ErrorList.
+package perr
+
+import (
+	"fmt"
+
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/token"
+)
+
+// Code is a machine-readable classification of an Error, so callers can
+// branch on the kind of failure without parsing the message.
+type Code int
+
+const (
+	ErrUnexpectedChar Code = iota
+	ErrUnexpectedToken
+	ErrUnknownIdent
+	ErrMissingRParen
+	ErrInvalidDeclaration
+	ErrUnexpectedEOF
+)
+
+// Error is a single positioned failure produced while lexing or parsing.
+type Error struct {
+	Pos  token.Position
+	Code Code
+	Msg  string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Pos, e.Msg)
+}
+
+// ErrorList collects errors encountered while processing a single input,
+// so that e.g. every bad declaration can be reported instead of
+// aborting on the first one.
+type ErrorList []*Error
+
+// Add appends a new Error to the list.
+func (l *ErrorList) Add(pos token.Position, code Code, msg string) {
+	*l = append(*l, &Error{Pos: pos, Code: code, Msg: msg})
+}
+
+// Err returns nil if the list is empty, or the list itself (as an error)
+// otherwise.
+func (l ErrorList) Err() error {
+	if len(l) == 0 {
+		return nil
+	}
+	return l
+}
+
+func (l ErrorList) Error() string {
+	switch len(l) {
+	case 0:
+		return "no errors"
+	case 1:
+		return l[0].Error()
+	}
+	return fmt.Sprintf("%s (and %d more errors)", l[0].Error(), len(l)-1)
+}
@@ -0,0 +1,184 @@
+// Package repl implements an interactive read-eval-print loop over the
+// parser and evaluator, keeping a persistent variable environment
+// across inputs instead of exiting on the first parse error.
+package repl
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/ast"
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/eval"
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/parser"
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/token"
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/truthtable"
+)
+
+// REPL holds the variable environment that persists across inputs.
+type REPL struct {
+	vars    map[string]bool
+	out     io.Writer
+	loading map[string]bool // files currently being :load-ed, to reject cycles
+}
+
+// New returns a REPL that writes its output to out.
+func New(out io.Writer) *REPL {
+	return &REPL{vars: make(map[string]bool), out: out, loading: make(map[string]bool)}
+}
+
+// Run reads lines from in until :quit or end of input, evaluating each
+// and printing its result without exiting on error.
+func (r *REPL) Run(in io.Reader) {
+	scanner := bufio.NewScanner(in)
+	for {
+		fmt.Fprint(r.out, "> ")
+		if !scanner.Scan() {
+			return
+		}
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if line == ":quit" {
+			return
+		}
+		r.handleLine(line)
+	}
+}
+
+func (r *REPL) handleLine(line string) {
+	switch {
+	case strings.HasPrefix(line, ":"):
+		r.handleCommand(line)
+	case parser.IsDeclaration(line):
+		r.handleDeclaration(line)
+	default:
+		r.handleExpression(line)
+	}
+}
+
+func (r *REPL) handleDeclaration(line string) {
+	name, value, err := parser.ParseDeclaration(line, token.Position{})
+	if err != nil {
+		fmt.Fprintln(r.out, err)
+		return
+	}
+	r.vars[name] = value
+}
+
+func (r *REPL) handleExpression(src string) {
+	expr, err := parser.Parse(src)
+	if err != nil {
+		fmt.Fprintln(r.out, err)
+		return
+	}
+
+	result, err := eval.Eval(expr, r.vars)
+	if err != nil {
+		fmt.Fprintln(r.out, err)
+		return
+	}
+
+	if result {
+		fmt.Fprintln(r.out, "True")
+	} else {
+		fmt.Fprintln(r.out, "False")
+	}
+}
+
+func (r *REPL) handleCommand(line string) {
+	fields := strings.Fields(line)
+	cmd := fields[0]
+	arg := strings.TrimSpace(strings.TrimPrefix(line, cmd))
+
+	switch cmd {
+	case ":vars":
+		r.printVars()
+
+	case ":unset":
+		if arg == "" {
+			fmt.Fprintln(r.out, "usage: :unset <name>")
+			return
+		}
+		delete(r.vars, arg)
+
+	case ":load":
+		if arg == "" {
+			fmt.Fprintln(r.out, "usage: :load <file>")
+			return
+		}
+		r.loadFile(arg)
+
+	case ":ast":
+		if arg == "" {
+			fmt.Fprintln(r.out, "usage: :ast <expr>")
+			return
+		}
+		expr, err := parser.Parse(arg)
+		if err != nil {
+			fmt.Fprintln(r.out, err)
+			return
+		}
+		fmt.Fprint(r.out, ast.Dump(expr))
+
+	case ":table":
+		if arg == "" {
+			fmt.Fprintln(r.out, "usage: :table <expr>")
+			return
+		}
+		expr, err := parser.Parse(arg)
+		if err != nil {
+			fmt.Fprintln(r.out, err)
+			return
+		}
+		fmt.Fprint(r.out, truthtable.Build(expr).Text())
+
+	default:
+		fmt.Fprintf(r.out, "unknown command %q\n", cmd)
+	}
+}
+
+func (r *REPL) printVars() {
+	names := make([]string, 0, len(r.vars))
+	for name := range r.vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := "False"
+		if r.vars[name] {
+			value = "True"
+		}
+		fmt.Fprintf(r.out, "%s = %s\n", name, value)
+	}
+}
+
+func (r *REPL) loadFile(path string) {
+	if r.loading[path] {
+		fmt.Fprintf(r.out, "%s: cyclic :load\n", path)
+		return
+	}
+	r.loading[path] = true
+	defer delete(r.loading, path)
+
+	f, err := os.Open(path)
+	if err != nil {
+		fmt.Fprintln(r.out, err)
+		return
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line == ":quit" {
+			continue
+		}
+		r.handleLine(line)
+	}
+}
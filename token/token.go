@@ -0,0 +1,51 @@
+// Package token defines the lexical tokens of the logical expression
+// language, modeled on the token/position conventions of go/token.
+package token
+
+import "fmt"
+
+// Position describes a location in the source text, analogous to
+// go/token.Position.
+type Position struct {
+	Filename string
+	Offset   int // byte offset, starting at 0
+	Line     int // line number, starting at 1
+	Column   int // byte offset within the line, starting at 1
+}
+
+// Pos is an alias for Position kept for brevity at call sites.
+type Pos = Position
+
+// IsValid reports whether the position carries line information.
+func (p Position) IsValid() bool { return p.Line > 0 }
+
+func (p Position) String() string {
+	s := p.Filename
+	if s == "" {
+		s = "<input>"
+	}
+	if p.IsValid() {
+		s += fmt.Sprintf(":%d:%d", p.Line, p.Column)
+	}
+	return s
+}
+
+// Type identifies the lexical class of a Token.
+type Type int
+
+const (
+	EOF Type = iota
+	IDENT
+	BOOL
+	OPERATOR
+	LPAREN
+	RPAREN
+)
+
+// Token is a single lexical token together with the position of its
+// first byte in the source.
+type Token struct {
+	Type  Type
+	Value string
+	Pos   Pos
+}
@@ -0,0 +1,162 @@
+package truthtable
+
+import "github.com/EvKrpv/Go-Logical_Expression_Parser/ast"
+
+// search looks for an extension of assignment over remaining (plus any
+// variables already bound in assignment) under which expr evaluates to
+// want. It is a DPLL-style backtracking search: evalPartial acts as
+// unit propagation, short-circuiting as soon as a subtree's value is
+// fixed regardless of its still-unassigned variables, and the recursion
+// only branches on a variable when that fails. The returned assignment
+// binds only the variables assigned before expr's value was fixed, so
+// some of remaining may be absent from it.
+func search(expr ast.Expr, remaining []string, assignment map[string]bool, want bool) (map[string]bool, bool) {
+	if value, ok := evalPartial(expr, assignment); ok {
+		if value == want {
+			return cloneAssignment(assignment), true
+		}
+		return nil, false
+	}
+
+	if len(remaining) == 0 {
+		return nil, false
+	}
+
+	name, rest := remaining[0], remaining[1:]
+	for _, branch := range []bool{true, false} {
+		assignment[name] = branch
+		if result, ok := search(expr, rest, assignment, want); ok {
+			delete(assignment, name)
+			return result, true
+		}
+	}
+	delete(assignment, name)
+	return nil, false
+}
+
+// evalPartial evaluates expr under a (possibly incomplete) assignment.
+// It returns ok == false when expr's value cannot be settled from the
+// bound variables operator by operator: and/or/implies/nand/nor
+// short-circuit as soon as one operand fixes the result, so e.g. "x or
+// y" is already known true once x is true, even if y is unassigned,
+// while xor and iff need both operands. A value that is fixed only by
+// the shape of the whole expression (e.g. "x or not x") is still
+// reported as unresolved while x is unbound.
+func evalPartial(expr ast.Expr, assignment map[string]bool) (value, ok bool) {
+	switch e := expr.(type) {
+	case *ast.BoolLit:
+		return e.Value, true
+
+	case *ast.Ident:
+		v, bound := assignment[e.Name]
+		return v, bound
+
+	case *ast.ParenExpr:
+		return evalPartial(e.X, assignment)
+
+	case *ast.UnaryOp:
+		v, ok := evalPartial(e.X, assignment)
+		if !ok {
+			return false, false
+		}
+		return !v, true
+
+	case *ast.BinaryOp:
+		switch e.Op {
+		case "and":
+			lv, lok := evalPartial(e.X, assignment)
+			if lok && !lv {
+				return false, true
+			}
+			rv, rok := evalPartial(e.Y, assignment)
+			if rok && !rv {
+				return false, true
+			}
+			if lok && rok {
+				return lv && rv, true
+			}
+			return false, false
+
+		case "or":
+			lv, lok := evalPartial(e.X, assignment)
+			if lok && lv {
+				return true, true
+			}
+			rv, rok := evalPartial(e.Y, assignment)
+			if rok && rv {
+				return true, true
+			}
+			if lok && rok {
+				return lv || rv, true
+			}
+			return false, false
+
+		case "xor":
+			lv, lok := evalPartial(e.X, assignment)
+			rv, rok := evalPartial(e.Y, assignment)
+			if lok && rok {
+				return (lv && !rv) || (!lv && rv), true
+			}
+			return false, false
+
+		case "implies":
+			lv, lok := evalPartial(e.X, assignment)
+			if lok && !lv {
+				return true, true
+			}
+			rv, rok := evalPartial(e.Y, assignment)
+			if rok && rv {
+				return true, true
+			}
+			if lok && rok {
+				return !lv || rv, true
+			}
+			return false, false
+
+		case "iff":
+			lv, lok := evalPartial(e.X, assignment)
+			rv, rok := evalPartial(e.Y, assignment)
+			if lok && rok {
+				return lv == rv, true
+			}
+			return false, false
+
+		case "nand":
+			lv, lok := evalPartial(e.X, assignment)
+			if lok && !lv {
+				return true, true
+			}
+			rv, rok := evalPartial(e.Y, assignment)
+			if rok && !rv {
+				return true, true
+			}
+			if lok && rok {
+				return !(lv && rv), true
+			}
+			return false, false
+
+		case "nor":
+			lv, lok := evalPartial(e.X, assignment)
+			if lok && lv {
+				return false, true
+			}
+			rv, rok := evalPartial(e.Y, assignment)
+			if rok && rv {
+				return false, true
+			}
+			if lok && rok {
+				return !(lv || rv), true
+			}
+			return false, false
+		}
+	}
+	return false, false
+}
+
+func cloneAssignment(assignment map[string]bool) map[string]bool {
+	out := make(map[string]bool, len(assignment))
+	for k, v := range assignment {
+		out[k] = v
+	}
+	return out
+}
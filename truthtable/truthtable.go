@@ -0,0 +1,189 @@
+// Package truthtable enumerates the assignments of a parsed expression's
+// free variables and reports its truth table, along with whether it is
+// a tautology, a contradiction, or merely satisfiable.
+package truthtable
+
+import (
+	"strings"
+
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/ast"
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/eval"
+)
+
+// dpllThreshold is the number of free variables above which Build stops
+// enumerating all 2^n assignments and instead answers IsTautology,
+// IsContradiction, and IsSatisfiable with a DPLL-style search.
+const dpllThreshold = 20
+
+// Row is one line of a truth table: an assignment of every free
+// variable, and the value expr takes under it.
+type Row struct {
+	Assignment map[string]bool
+	Value      bool
+}
+
+// Table is the result of evaluating an expression over its free
+// variables. For expressions with more than dpllThreshold variables,
+// Rows is left nil and the tautology/contradiction/satisfiable queries
+// are answered by search instead of full enumeration.
+type Table struct {
+	Expr ast.Expr
+	Vars []string
+	Rows []Row
+
+	tautology     bool
+	contradiction bool
+	satisfiable   bool
+}
+
+// Build evaluates expr over every assignment of its free variables (or,
+// once there are too many to enumerate, searches for satisfying and
+// falsifying assignments) and returns the resulting Table.
+func Build(expr ast.Expr) *Table {
+	t := &Table{Expr: expr, Vars: ast.FreeVars(expr)}
+	if len(t.Vars) <= dpllThreshold {
+		t.buildFull()
+	} else {
+		t.buildSearch()
+	}
+	return t
+}
+
+func (t *Table) buildFull() {
+	n := len(t.Vars)
+	any := false
+	allTrue := true
+
+	for mask := 0; mask < (1 << n); mask++ {
+		assignment := make(map[string]bool, n)
+		for i, name := range t.Vars {
+			assignment[name] = mask&(1<<i) != 0
+		}
+
+		value, err := eval.Eval(t.Expr, assignment)
+		if err != nil {
+			// Every free variable is bound above, so this should be
+			// unreachable; skip defensively rather than panic.
+			continue
+		}
+
+		t.Rows = append(t.Rows, Row{Assignment: assignment, Value: value})
+		if value {
+			any = true
+		} else {
+			allTrue = false
+		}
+	}
+
+	t.satisfiable = any
+	t.tautology = allTrue
+	t.contradiction = !any
+}
+
+func (t *Table) buildSearch() {
+	if _, ok := search(t.Expr, t.Vars, map[string]bool{}, true); ok {
+		t.satisfiable = true
+	}
+	if _, ok := search(t.Expr, t.Vars, map[string]bool{}, false); !ok {
+		t.tautology = true
+	}
+	t.contradiction = !t.satisfiable
+}
+
+// IsTautology reports whether expr is true under every assignment.
+func (t *Table) IsTautology() bool { return t.tautology }
+
+// IsContradiction reports whether expr is false under every assignment.
+func (t *Table) IsContradiction() bool { return t.contradiction }
+
+// IsSatisfiable reports whether some assignment makes expr true.
+func (t *Table) IsSatisfiable() bool { return t.satisfiable }
+
+// SatisfyingAssignments returns every assignment that makes expr true.
+// When the table was built via search rather than full enumeration, at
+// most one assignment is returned, since search only proves
+// satisfiability rather than enumerating every witness.
+func (t *Table) SatisfyingAssignments() []map[string]bool {
+	if t.Rows != nil {
+		var out []map[string]bool
+		for _, row := range t.Rows {
+			if row.Value {
+				out = append(out, row.Assignment)
+			}
+		}
+		return out
+	}
+
+	if assignment, ok := search(t.Expr, t.Vars, map[string]bool{}, true); ok {
+		return []map[string]bool{assignment}
+	}
+	return nil
+}
+
+// Text renders the table as whitespace-aligned plain text.
+func (t *Table) Text() string {
+	var b strings.Builder
+	writeHeader(&b, t.Vars, "\t", "")
+	for _, row := range t.Rows {
+		writeRow(&b, t.Vars, row, "\t", "")
+	}
+	return b.String()
+}
+
+// CSV renders the table as comma-separated values.
+func (t *Table) CSV() string {
+	var b strings.Builder
+	writeHeader(&b, t.Vars, ",", "")
+	for _, row := range t.Rows {
+		writeRow(&b, t.Vars, row, ",", "")
+	}
+	return b.String()
+}
+
+// Markdown renders the table as a GitHub-flavored Markdown table.
+func (t *Table) Markdown() string {
+	var b strings.Builder
+	writeHeader(&b, t.Vars, " | ", "| ")
+	b.WriteString("|")
+	for range t.Vars {
+		b.WriteString(" --- |")
+	}
+	b.WriteString(" --- |\n")
+	for _, row := range t.Rows {
+		writeRow(&b, t.Vars, row, " | ", "| ")
+	}
+	return b.String()
+}
+
+func writeHeader(b *strings.Builder, vars []string, sep, prefix string) {
+	b.WriteString(prefix)
+	for _, name := range vars {
+		b.WriteString(name)
+		b.WriteString(sep)
+	}
+	b.WriteString("result")
+	if prefix != "" {
+		b.WriteString(" |")
+	}
+	b.WriteString("\n")
+}
+
+func writeRow(b *strings.Builder, vars []string, row Row, sep, prefix string) {
+	b.WriteString(prefix)
+	for _, name := range vars {
+		b.WriteString(boolString(row.Assignment[name]))
+		b.WriteString(sep)
+	}
+	b.WriteString(boolString(row.Value))
+	if prefix != "" {
+		b.WriteString(" |")
+	}
+	b.WriteString("\n")
+}
+
+func boolString(v bool) string {
+	if v {
+		return "True"
+	}
+	return "False"
+}
@@ -0,0 +1,156 @@
+package truthtable_test
+
+import (
+	"testing"
+
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/ast"
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/eval"
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/parser"
+	"github.com/EvKrpv/Go-Logical_Expression_Parser/truthtable"
+)
+
+func mustParse(t *testing.T, src string) ast.Expr {
+	t.Helper()
+	expr, err := parser.Parse(src)
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", src, err)
+	}
+	return expr
+}
+
+func TestBuildSmall(t *testing.T) {
+	expr := mustParse(t, "a and b")
+	table := truthtable.Build(expr)
+
+	if table.Rows == nil {
+		t.Fatal("expected full enumeration for 2 variables")
+	}
+	if len(table.Rows) != 4 {
+		t.Fatalf("got %d rows, want 4", len(table.Rows))
+	}
+	if !table.IsSatisfiable() || table.IsTautology() || table.IsContradiction() {
+		t.Errorf("a and b: satisfiable=%v tautology=%v contradiction=%v, want sat only",
+			table.IsSatisfiable(), table.IsTautology(), table.IsContradiction())
+	}
+}
+
+// bruteForce independently evaluates expr over every assignment of vars
+// using eval.Eval, the package this test is meant to check search.go
+// against, and reports whether some assignment satisfies it and whether
+// every assignment does.
+func bruteForce(t *testing.T, expr ast.Expr, vars []string) (satisfiable, tautology bool) {
+	t.Helper()
+	n := len(vars)
+	any, allTrue := false, true
+
+	for mask := 0; mask < (1 << n); mask++ {
+		assignment := make(map[string]bool, n)
+		for i, name := range vars {
+			assignment[name] = mask&(1<<i) != 0
+		}
+		value, err := eval.Eval(expr, assignment)
+		if err != nil {
+			t.Fatalf("Eval: %v", err)
+		}
+		if value {
+			any = true
+		} else {
+			allTrue = false
+		}
+	}
+	return any, allTrue
+}
+
+// TestSearchFallbackAllOperators builds a 21-free-variable expression
+// (one more than dpllThreshold) that exercises all eight binary/unary
+// operators, forcing Build onto the DPLL-style search path, and checks
+// its answers against an independent brute-force enumeration driven by
+// eval.Eval.
+func TestSearchFallbackAllOperators(t *testing.T) {
+	// 21 single-letter variables (a..u); identifiers may only contain
+	// lowercase letters, so numbered names like v0 are not an option.
+	src := "a and b or c xor d implies e iff f nand g nor h " +
+		"and not i and j and k and l and m and n and o " +
+		"and p and q and r and s and t and u"
+	expr := mustParse(t, src)
+
+	vars := ast.FreeVars(expr)
+	if len(vars) != 21 {
+		t.Fatalf("got %d free variables, want 21", len(vars))
+	}
+
+	table := truthtable.Build(expr)
+	if table.Rows != nil {
+		t.Fatal("expected the search fallback (no full enumeration) for 21 variables")
+	}
+
+	wantSat, wantTaut := bruteForce(t, expr, vars)
+	if got := table.IsSatisfiable(); got != wantSat {
+		t.Errorf("IsSatisfiable() = %v, want %v", got, wantSat)
+	}
+	if got := table.IsTautology(); got != wantTaut {
+		t.Errorf("IsTautology() = %v, want %v", got, wantTaut)
+	}
+	if got, want := table.IsContradiction(), !wantSat; got != want {
+		t.Errorf("IsContradiction() = %v, want %v", got, want)
+	}
+
+	for _, assignment := range table.SatisfyingAssignments() {
+		value, err := eval.Eval(expr, assignment)
+		if err != nil {
+			t.Fatalf("Eval(witness): %v", err)
+		}
+		if !value {
+			t.Errorf("SatisfyingAssignments returned %v, which does not satisfy expr", assignment)
+		}
+	}
+}
+
+// TestSearchFallbackPerOperator checks each operator individually, still
+// over 21 variables, so a single mishandled case in evalPartial (e.g.
+// one of implies/iff/nand/nor falling through to "still unresolved")
+// can't hide behind the others.
+func TestSearchFallbackPerOperator(t *testing.T) {
+	// 18 padding variables (d..u), plus c appended after them, so each
+	// 2-variable operator expression below still has 21 free variables in
+	// total (a..u, with a and b as the two operands under test).
+	pad := " and d and e and f and g and h and i and j and k and l and m " +
+		"and n and o and p and q and r and s and t and u"
+
+	tests := []struct {
+		name string
+		src  string
+	}{
+		{"and", "a and b" + pad + " and c"},
+		{"or", "a or b" + pad + " and c"},
+		{"xor", "a xor b" + pad + " and c"},
+		{"not", "not a and b" + pad + " and c"},
+		{"implies", "a implies b" + pad + " and c"},
+		{"iff", "a iff b" + pad + " and c"},
+		{"nand", "a nand b" + pad + " and c"},
+		{"nor", "a nor b" + pad + " and c"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr := mustParse(t, tt.src)
+			vars := ast.FreeVars(expr)
+			if len(vars) <= 20 {
+				t.Fatalf("got %d free variables, want >20 to force the search fallback", len(vars))
+			}
+
+			table := truthtable.Build(expr)
+			if table.Rows != nil {
+				t.Fatal("expected the search fallback, not full enumeration")
+			}
+
+			wantSat, wantTaut := bruteForce(t, expr, vars)
+			if got := table.IsSatisfiable(); got != wantSat {
+				t.Errorf("IsSatisfiable() = %v, want %v", got, wantSat)
+			}
+			if got := table.IsTautology(); got != wantTaut {
+				t.Errorf("IsTautology() = %v, want %v", got, wantTaut)
+			}
+		})
+	}
+}